@@ -0,0 +1,68 @@
+package id
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// base58Alphabet is the Bitcoin base58 alphabet. It omits the easily confused
+// characters 0, O, I, and l.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var base58Indices = func() [256]int {
+	indices := [256]int{}
+	for i := range indices {
+		indices[i] = -1
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		indices[base58Alphabet[i]] = i
+	}
+	return indices
+}()
+
+// encodeBase58 returns the base58 string representation of the data. Each
+// leading zero byte is represented by a leading "1".
+func encodeBase58(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	n := new(big.Int).SetBytes(data)
+	digits := make([]byte, 0, len(data)*138/100+1)
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		digits = append(digits, base58Alphabet[mod.Int64()])
+	}
+	for i := 0; i < zeros; i++ {
+		digits = append(digits, base58Alphabet[0])
+	}
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+	return string(digits)
+}
+
+// decodeBase58 returns the data represented by the base58 string. It returns an
+// error if the string contains characters outside of the base58 alphabet.
+func decodeBase58(str string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(str) && str[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	radix := big.NewInt(58)
+	digit := new(big.Int)
+	n := new(big.Int)
+	for i := 0; i < len(str); i++ {
+		index := base58Indices[str[i]]
+		if index < 0 {
+			return nil, fmt.Errorf("invalid base58 character=%q at index=%v", str[i], i)
+		}
+		n.Mul(n, radix)
+		n.Add(n, digit.SetInt64(int64(index)))
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
@@ -0,0 +1,82 @@
+package id
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+// SizeHintFrameChecksum is the number of bytes used by the checksum at the
+// start of a frame.
+const SizeHintFrameChecksum = 4
+
+// FrameHash returns the Hash in binary, prefixed with a big-endian CRC32
+// (IEEE) checksum of the Hash. It is intended for transports that can corrupt
+// bytes, so that UnframeHash can reject corrupted frames.
+func FrameHash(hash Hash) []byte {
+	return frame(hash[:])
+}
+
+// UnframeHash returns the Hash from a frame produced by FrameHash. It returns
+// an error if the frame has the wrong length, or if the checksum does not
+// match.
+func UnframeHash(data []byte) (Hash, error) {
+	unframed, err := unframe(data, SizeHintHash)
+	if err != nil {
+		return Hash{}, err
+	}
+	return HashFromBytes(unframed)
+}
+
+// FrameSignature returns the Signature in binary, prefixed with a big-endian
+// CRC32 (IEEE) checksum of the Signature.
+func FrameSignature(signature Signature) []byte {
+	return frame(signature[:])
+}
+
+// UnframeSignature returns the Signature from a frame produced by
+// FrameSignature. It returns an error if the frame has the wrong length, or if
+// the checksum does not match.
+func UnframeSignature(data []byte) (Signature, error) {
+	unframed, err := unframe(data, SizeHintSignature)
+	if err != nil {
+		return Signature{}, err
+	}
+	return SignatureFromBytes(unframed)
+}
+
+// FrameSignatory returns the Signatory in binary, prefixed with a big-endian
+// CRC32 (IEEE) checksum of the Signatory.
+func FrameSignatory(signatory Signatory) []byte {
+	return frame(signatory[:])
+}
+
+// UnframeSignatory returns the Signatory from a frame produced by
+// FrameSignatory. It returns an error if the frame has the wrong length, or if
+// the checksum does not match.
+func UnframeSignatory(data []byte) (Signatory, error) {
+	unframed, err := unframe(data, SizeHintSignatory)
+	if err != nil {
+		return Signatory{}, err
+	}
+	return SignatoryFromBytes(unframed)
+}
+
+func frame(data []byte) []byte {
+	framed := make([]byte, SizeHintFrameChecksum+len(data))
+	binary.BigEndian.PutUint32(framed, crc32.ChecksumIEEE(data))
+	copy(framed[SizeHintFrameChecksum:], data)
+	return framed
+}
+
+func unframe(data []byte, size int) ([]byte, error) {
+	if len(data) != SizeHintFrameChecksum+size {
+		return nil, fmt.Errorf("expected len=%v, got len=%v", SizeHintFrameChecksum+size, len(data))
+	}
+	expected := binary.BigEndian.Uint32(data)
+	unframed := data[SizeHintFrameChecksum:]
+	if got := crc32.ChecksumIEEE(unframed); got != expected {
+		return nil, fmt.Errorf("expected checksum=%v, got checksum=%v", expected, got)
+	}
+	return unframed, nil
+}
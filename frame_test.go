@@ -0,0 +1,108 @@
+package id_test
+
+import (
+	"testing/quick"
+
+	"github.com/muirglacier/id"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Frames", func() {
+	Context("when framing and then unframing hashes", func() {
+		It("should equal itself", func() {
+			f := func(data [32]byte) bool {
+				framed := id.FrameHash(id.Hash(data))
+				Expect(framed).To(HaveLen(id.SizeHintFrameChecksum + id.SizeHintHash))
+				unframed, err := id.UnframeHash(framed)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unframed).To(Equal(id.Hash(data)))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when a byte is corrupted", func() {
+			f := func(data [32]byte, i, mask uint8) bool {
+				if mask == 0 {
+					return true
+				}
+				framed := id.FrameHash(id.Hash(data))
+				framed[int(i)%len(framed)] ^= mask
+				_, err := id.UnframeHash(framed)
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when the length is wrong", func() {
+			f := func(data [32]byte) bool {
+				framed := id.FrameHash(id.Hash(data))
+				_, err := id.UnframeHash(framed[:len(framed)-1])
+				Expect(err).To(HaveOccurred())
+				_, err = id.UnframeHash(append(framed, 0))
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when framing and then unframing signatures", func() {
+		It("should equal itself", func() {
+			f := func(data [65]byte) bool {
+				framed := id.FrameSignature(id.Signature(data))
+				Expect(framed).To(HaveLen(id.SizeHintFrameChecksum + id.SizeHintSignature))
+				unframed, err := id.UnframeSignature(framed)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unframed).To(Equal(id.Signature(data)))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when a byte is corrupted", func() {
+			f := func(data [65]byte, i, mask uint8) bool {
+				if mask == 0 {
+					return true
+				}
+				framed := id.FrameSignature(id.Signature(data))
+				framed[int(i)%len(framed)] ^= mask
+				_, err := id.UnframeSignature(framed)
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when framing and then unframing signatories", func() {
+		It("should equal itself", func() {
+			f := func(data [32]byte) bool {
+				framed := id.FrameSignatory(id.Signatory(data))
+				Expect(framed).To(HaveLen(id.SizeHintFrameChecksum + id.SizeHintSignatory))
+				unframed, err := id.UnframeSignatory(framed)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unframed).To(Equal(id.Signatory(data)))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when a byte is corrupted", func() {
+			f := func(data [32]byte, i, mask uint8) bool {
+				if mask == 0 {
+					return true
+				}
+				framed := id.FrameSignatory(id.Signatory(data))
+				framed[int(i)%len(framed)] ^= mask
+				_, err := id.UnframeSignatory(framed)
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+})
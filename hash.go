@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"unsafe"
@@ -23,6 +24,17 @@ func NewHash(data []byte) Hash {
 	return sha256.Sum256(data)
 }
 
+// HashFromBytes returns a Hash with the same content as the slice of bytes. It
+// returns an error if the slice is not exactly SizeHintHash bytes long.
+func HashFromBytes(data []byte) (Hash, error) {
+	if len(data) != SizeHintHash {
+		return Hash{}, fmt.Errorf("expected len=%v, got len=%v", SizeHintHash, len(data))
+	}
+	hash := Hash{}
+	copy(hash[:], data)
+	return hash, nil
+}
+
 // Equal compares one Hash with another. If they are equal, then it returns
 // true, otherwise it returns false.
 func (hash Hash) Equal(other *Hash) bool {
@@ -81,6 +93,59 @@ func (hash Hash) String() string {
 	return base64.RawURLEncoding.EncodeToString(hash[:])
 }
 
+// HasPrefix returns true if the Hash begins with the prefix, otherwise it
+// returns false. An empty prefix is a prefix of every Hash.
+func (hash Hash) HasPrefix(prefix []byte) bool {
+	return bytes.HasPrefix(hash[:], prefix)
+}
+
+// SharedPrefixBytes returns the number of leading bytes that are the same in
+// both Hashes.
+func (hash Hash) SharedPrefixBytes(other *Hash) int {
+	for i := range hash {
+		if hash[i] != other[i] {
+			return i
+		}
+	}
+	return SizeHintHash
+}
+
+// Shard maps the Hash to one of n shards. The leading 8 bytes of the Hash are
+// interpreted as a big-endian integer, and the shard index is that integer
+// modulo n. This function will panic if n is zero.
+func (hash Hash) Shard(n uint32) uint32 {
+	if n == 0 {
+		panic(fmt.Errorf("expected n>0, got n=%v", n))
+	}
+	return uint32(binary.BigEndian.Uint64(hash[:8]) % uint64(n))
+}
+
+// Base58 returns the base58 string representation of the Hash, using the
+// Bitcoin alphabet. It is shorter than hex and safe to use in URLs.
+func (hash Hash) Base58() string {
+	return encodeBase58(hash[:])
+}
+
+// maxBase58LenHash is the maximum length of the base58 string representation
+// of a Hash.
+const maxBase58LenHash = 44
+
+// HashFromBase58 returns the Hash represented by a base58 string, using the
+// Bitcoin alphabet. It returns an error if the string contains invalid
+// characters, or does not represent exactly SizeHintHash bytes. Strings that
+// are too long to represent a Hash are rejected before decoding, so it is safe
+// to use with untrusted input.
+func HashFromBase58(str string) (Hash, error) {
+	if len(str) > maxBase58LenHash {
+		return Hash{}, fmt.Errorf("expected len<=%v, got len=%v", maxBase58LenHash, len(str))
+	}
+	decoded, err := decodeBase58(str)
+	if err != nil {
+		return Hash{}, err
+	}
+	return HashFromBytes(decoded)
+}
+
 // NewMerkleHash returns the root hash of the merkle tree that uses the hashes
 // as leaves. The hashes are recursively hashed in pairs from left-to-right,
 // with odd hashes trailing at the front. This function does not allow the
@@ -5,8 +5,10 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/json"
+	"strings"
 	"testing"
 	"testing/quick"
+	"time"
 
 	"github.com/muirglacier/id"
 	"github.com/muirglacier/surge"
@@ -28,6 +30,36 @@ var _ = Describe("Hashes", func() {
 		})
 	})
 
+	Context("when converting from bytes", func() {
+		It("should equal the bytes when the length is correct", func() {
+			f := func(data [32]byte) bool {
+				got, err := id.HashFromBytes(data[:])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(got).To(Equal(id.Hash(data)))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when the bytes are too short", func() {
+			f := func(data [32]byte, n uint8) bool {
+				_, err := id.HashFromBytes(data[:int(n)%32])
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when the bytes are too long", func() {
+			f := func(data [32]byte, extra []byte) bool {
+				_, err := id.HashFromBytes(append(data[:], append(extra, 0)...))
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
 	Context("when marshaling and then unmarshaling using binary", func() {
 		It("should equal itself", func() {
 			f := func(data []byte) bool {
@@ -100,6 +132,163 @@ var _ = Describe("Hashes", func() {
 		})
 	})
 
+	Context("when checking prefixes", func() {
+		It("should return true for an empty prefix", func() {
+			f := func(data [32]byte) bool {
+				Expect(id.Hash(data).HasPrefix(nil)).To(BeTrue())
+				Expect(id.Hash(data).HasPrefix([]byte{})).To(BeTrue())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return true for a matching prefix", func() {
+			f := func(data [32]byte, n uint8) bool {
+				Expect(id.Hash(data).HasPrefix(data[:int(n)%33])).To(BeTrue())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return false for a non-matching prefix", func() {
+			f := func(data [32]byte, n uint8) bool {
+				prefix := append([]byte{}, data[:int(n)%32+1]...)
+				prefix[len(prefix)-1]++
+				Expect(id.Hash(data).HasPrefix(prefix)).To(BeFalse())
+				Expect(id.Hash(data).HasPrefix(append(data[:], 0))).To(BeFalse())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when counting shared prefix bytes", func() {
+		It("should return the length of the shared prefix", func() {
+			f := func(data [32]byte, n uint8) bool {
+				hash := id.Hash(data)
+				other := hash
+				Expect(hash.SharedPrefixBytes(&other)).To(Equal(32))
+				i := int(n) % 32
+				other[i]++
+				Expect(hash.SharedPrefixBytes(&other)).To(Equal(i))
+				Expect(other.SharedPrefixBytes(&hash)).To(Equal(i))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when sharding", func() {
+		It("should always return zero for one shard", func() {
+			f := func(data [32]byte) bool {
+				Expect(id.Hash(data).Shard(1)).To(Equal(uint32(0)))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return the last of the leading bytes for 256 shards", func() {
+			f := func(data [32]byte) bool {
+				Expect(id.Hash(data).Shard(256)).To(Equal(uint32(data[7])))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should distribute random hashes uniformly", func() {
+			n := uint32(16)
+			samples := 160000
+			counts := make([]int, n)
+			for i := 0; i < samples; i++ {
+				hash := id.Hash{}
+				rand.Read(hash[:])
+				shard := hash.Shard(n)
+				Expect(shard).To(BeNumerically("<", n))
+				counts[shard]++
+			}
+			expected := samples / int(n)
+			for _, count := range counts {
+				Expect(count).To(BeNumerically("~", expected, expected/10))
+			}
+		})
+
+		It("should panic for zero shards", func() {
+			Expect(func() { id.Hash{}.Shard(0) }).To(Panic())
+		})
+	})
+
+	Context("when encoding and then decoding using base58", func() {
+		It("should equal itself", func() {
+			f := func(data [32]byte) bool {
+				hash := id.Hash(data)
+				decoded, err := id.HashFromBase58(hash.Base58())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decoded).To(Equal(hash))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should equal itself for the zero hash", func() {
+			hash := id.Hash{}
+			Expect(hash.Base58()).To(Equal("11111111111111111111111111111111"))
+			decoded, err := id.HashFromBase58(hash.Base58())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decoded).To(Equal(hash))
+		})
+
+		It("should equal the known encoding", func() {
+			hash := id.NewHash([]byte("abc"))
+			Expect(hash.Base58()).To(Equal("DYu3G8aGTMBW1WrTw76zxQJQU4DHLw9MLyy7peG4LKkY"))
+		})
+
+		It("should be shorter than hex", func() {
+			f := func(data [32]byte) bool {
+				Expect(len(id.Hash(data).Base58())).To(BeNumerically("<=", 44))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when decoding invalid base58", func() {
+		It("should return an error for invalid characters", func() {
+			f := func(data [32]byte) bool {
+				str := id.Hash(data).Base58()
+				for _, c := range []string{"0", "O", "I", "l", "+", "/"} {
+					_, err := id.HashFromBase58(str[:10] + c + str[11:])
+					Expect(err).To(HaveOccurred())
+				}
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error for the wrong length", func() {
+			f := func(data [32]byte) bool {
+				str := id.Hash(data).Base58()
+				_, err := id.HashFromBase58(str[:len(str)-6])
+				Expect(err).To(HaveOccurred())
+				_, err = id.HashFromBase58("1" + str)
+				Expect(err).To(HaveOccurred())
+				_, err = id.HashFromBase58(str + "zzzzzz")
+				Expect(err).To(HaveOccurred())
+				_, err = id.HashFromBase58("")
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error quickly for a very long string", func() {
+			str := strings.Repeat("z", 1000000)
+			start := time.Now()
+			_, err := id.HashFromBase58(str)
+			Expect(err).To(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically("<", 10*time.Millisecond))
+		})
+	})
+
 	Context("when computing the merkle hash", func() {
 		Context("when using the safe and unsafe implementations", func() {
 			Context("when computing the merkle hash of zero hashes", func() {
@@ -0,0 +1,77 @@
+package id
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// HashLRU is a bounded cache of values keyed by Hash. When the cache is full,
+// adding a new Hash evicts the least-recently-used entry. It is safe for
+// concurrent use.
+type HashLRU struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[Hash]*list.Element
+}
+
+type hashLRUEntry struct {
+	hash  Hash
+	value interface{}
+}
+
+// NewHashLRU returns an empty HashLRU that holds at most capacity entries. This
+// function will panic if the capacity is not positive.
+func NewHashLRU(capacity int) *HashLRU {
+	if capacity <= 0 {
+		panic(fmt.Errorf("expected capacity>0, got capacity=%v", capacity))
+	}
+	return &HashLRU{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[Hash]*list.Element, capacity),
+	}
+}
+
+// Add a value to the HashLRU, marking it as the most-recently-used entry. If
+// the Hash is already present, its value is replaced. If the HashLRU is full,
+// the least-recently-used entry is evicted.
+func (lru *HashLRU) Add(hash Hash, value interface{}) {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+
+	if elem, ok := lru.entries[hash]; ok {
+		elem.Value.(*hashLRUEntry).value = value
+		lru.order.MoveToFront(elem)
+		return
+	}
+	lru.entries[hash] = lru.order.PushFront(&hashLRUEntry{hash: hash, value: value})
+	if lru.order.Len() > lru.capacity {
+		oldest := lru.order.Back()
+		lru.order.Remove(oldest)
+		delete(lru.entries, oldest.Value.(*hashLRUEntry).hash)
+	}
+}
+
+// Get the value for a Hash, marking it as the most-recently-used entry. It
+// returns false if the Hash is not present.
+func (lru *HashLRU) Get(hash Hash) (interface{}, bool) {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+
+	elem, ok := lru.entries[hash]
+	if !ok {
+		return nil, false
+	}
+	lru.order.MoveToFront(elem)
+	return elem.Value.(*hashLRUEntry).value, true
+}
+
+// Len returns the number of entries in the HashLRU.
+func (lru *HashLRU) Len() int {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+
+	return lru.order.Len()
+}
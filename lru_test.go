@@ -0,0 +1,108 @@
+package id_test
+
+import (
+	"sync"
+	"testing/quick"
+
+	"github.com/muirglacier/id"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Hash LRUs", func() {
+	Context("when adding and then getting values", func() {
+		It("should return the value", func() {
+			f := func(data []byte, value int) bool {
+				lru := id.NewHashLRU(1)
+				hash := id.NewHash(data)
+				lru.Add(hash, value)
+				got, ok := lru.Get(hash)
+				Expect(ok).To(BeTrue())
+				Expect(got).To(Equal(value))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return the latest value", func() {
+			f := func(data []byte, value, otherValue int) bool {
+				lru := id.NewHashLRU(2)
+				hash := id.NewHash(data)
+				lru.Add(hash, value)
+				lru.Add(hash, otherValue)
+				got, ok := lru.Get(hash)
+				Expect(ok).To(BeTrue())
+				Expect(got).To(Equal(otherValue))
+				Expect(lru.Len()).To(Equal(1))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when getting an absent value", func() {
+		It("should return false", func() {
+			f := func(data []byte) bool {
+				lru := id.NewHashLRU(1)
+				_, ok := lru.Get(id.NewHash(data))
+				Expect(ok).To(BeFalse())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when adding more values than the capacity", func() {
+		It("should evict the least-recently-used value", func() {
+			hashes := make([]id.Hash, 4)
+			for i := range hashes {
+				hashes[i] = id.NewHash([]byte{byte(i)})
+			}
+			lru := id.NewHashLRU(3)
+			lru.Add(hashes[0], 0)
+			lru.Add(hashes[1], 1)
+			lru.Add(hashes[2], 2)
+
+			// Using the first hash makes the second hash the least-recently-used.
+			_, ok := lru.Get(hashes[0])
+			Expect(ok).To(BeTrue())
+			lru.Add(hashes[3], 3)
+			Expect(lru.Len()).To(Equal(3))
+
+			_, ok = lru.Get(hashes[1])
+			Expect(ok).To(BeFalse())
+			for _, i := range []int{0, 2, 3} {
+				got, ok := lru.Get(hashes[i])
+				Expect(ok).To(BeTrue())
+				Expect(got).To(Equal(i))
+			}
+		})
+	})
+
+	Context("when adding and getting values concurrently", func() {
+		It("should not exceed the capacity", func() {
+			lru := id.NewHashLRU(10)
+			wg := new(sync.WaitGroup)
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					for j := 0; j < 1000; j++ {
+						hash := id.NewHash([]byte{byte(i), byte(j), byte(j >> 8)})
+						lru.Add(hash, j)
+						lru.Get(hash)
+					}
+				}(i)
+			}
+			wg.Wait()
+			Expect(lru.Len()).To(Equal(10))
+		})
+	})
+
+	Context("when the capacity is not positive", func() {
+		It("should panic", func() {
+			Expect(func() { id.NewHashLRU(0) }).To(Panic())
+		})
+	})
+})
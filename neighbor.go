@@ -0,0 +1,103 @@
+package id
+
+import (
+	"bytes"
+	"container/heap"
+)
+
+// Distance returns the XOR distance between two Signatories. Smaller values,
+// compared as big-endian integers, are nearer.
+func (signatory Signatory) Distance(other *Signatory) Hash {
+	distance := Hash{}
+	for i := range distance {
+		distance[i] = signatory[i] ^ other[i]
+	}
+	return distance
+}
+
+// A NeighborHeap maintains a running set of the k nearest Signatories, as
+// judged by their distances. It is a max-heap on distance, so the farthest of
+// the retained Signatories is always the next to be popped, and pushing onto a
+// full heap costs O(log k). It is not safe for concurrent use.
+type NeighborHeap struct {
+	capacity  int
+	neighbors neighbors
+}
+
+// NewNeighborHeap returns an empty NeighborHeap that retains at most k
+// Signatories.
+func NewNeighborHeap(k int) *NeighborHeap {
+	return &NeighborHeap{capacity: k}
+}
+
+// Len returns the number of Signatories retained by the NeighborHeap.
+func (nh *NeighborHeap) Len() int {
+	return len(nh.neighbors)
+}
+
+// Push a Signatory with its distance. If the NeighborHeap is full, the
+// Signatory replaces the farthest retained Signatory when it is nearer than it,
+// and is otherwise ignored.
+func (nh *NeighborHeap) Push(signatory Signatory, distance Hash) {
+	if nh.capacity <= 0 {
+		return
+	}
+	if len(nh.neighbors) < nh.capacity {
+		heap.Push(&nh.neighbors, neighbor{signatory: signatory, distance: distance})
+		return
+	}
+	if bytes.Compare(distance[:], nh.neighbors[0].distance[:]) < 0 {
+		nh.neighbors[0] = neighbor{signatory: signatory, distance: distance}
+		heap.Fix(&nh.neighbors, 0)
+	}
+}
+
+// Pop the farthest retained Signatory and return it with its distance. This
+// function will panic if the NeighborHeap is empty.
+func (nh *NeighborHeap) Pop() (Signatory, Hash) {
+	n := heap.Pop(&nh.neighbors).(neighbor)
+	return n.signatory, n.distance
+}
+
+// Signatories returns the retained Signatories, ordered from nearest to
+// farthest. The NeighborHeap is unmodified.
+func (nh *NeighborHeap) Signatories() Signatories {
+	cp := NeighborHeap{neighbors: make(neighbors, len(nh.neighbors))}
+	copy(cp.neighbors, nh.neighbors)
+	signatories := make(Signatories, len(cp.neighbors))
+	for i := len(signatories) - 1; i >= 0; i-- {
+		signatories[i], _ = cp.Pop()
+	}
+	return signatories
+}
+
+type neighbor struct {
+	signatory Signatory
+	distance  Hash
+}
+
+// neighbors implements the heap.Interface as a max-heap on distance.
+type neighbors []neighbor
+
+func (ns neighbors) Len() int {
+	return len(ns)
+}
+
+func (ns neighbors) Less(i, j int) bool {
+	return bytes.Compare(ns[i].distance[:], ns[j].distance[:]) > 0
+}
+
+func (ns neighbors) Swap(i, j int) {
+	ns[i], ns[j] = ns[j], ns[i]
+}
+
+func (ns *neighbors) Push(x interface{}) {
+	*ns = append(*ns, x.(neighbor))
+}
+
+func (ns *neighbors) Pop() interface{} {
+	old := *ns
+	n := old[len(old)-1]
+	*ns = old[:len(old)-1]
+	return n
+}
@@ -0,0 +1,78 @@
+package id_test
+
+import (
+	"bytes"
+	"sort"
+	"testing/quick"
+
+	"github.com/muirglacier/id"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Neighbor heaps", func() {
+	nearest := func(target id.Signatory, signatories id.Signatories, k int) id.Signatories {
+		sorted := make(id.Signatories, len(signatories))
+		copy(sorted, signatories)
+		sort.Slice(sorted, func(i, j int) bool {
+			di := sorted[i].Distance(&target)
+			dj := sorted[j].Distance(&target)
+			return bytes.Compare(di[:], dj[:]) < 0
+		})
+		if len(sorted) > k {
+			sorted = sorted[:k]
+		}
+		return sorted
+	}
+
+	Context("when computing distances", func() {
+		It("should be symmetric and zero for itself", func() {
+			f := func(a, b [32]byte) bool {
+				x, y := id.Signatory(a), id.Signatory(b)
+				Expect(x.Distance(&y)).To(Equal(y.Distance(&x)))
+				Expect(x.Distance(&x)).To(Equal(id.Hash{}))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when pushing many signatories", func() {
+		It("should retain the k nearest to the target", func() {
+			f := func(target [32]byte, k uint8) bool {
+				signatories := randomSignatories(500)
+				nh := id.NewNeighborHeap(int(k))
+				for _, signatory := range signatories {
+					nh.Push(signatory, signatory.Distance((*id.Signatory)(&target)))
+				}
+				expected := nearest(target, signatories, int(k))
+				Expect(nh.Len()).To(Equal(len(expected)))
+				Expect(nh.Signatories()).To(Equal(expected))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when popping", func() {
+		It("should return the farthest signatory first", func() {
+			f := func(target [32]byte) bool {
+				signatories := randomSignatories(100)
+				nh := id.NewNeighborHeap(10)
+				for _, signatory := range signatories {
+					nh.Push(signatory, signatory.Distance((*id.Signatory)(&target)))
+				}
+				expected := nearest(target, signatories, 10)
+				for i := len(expected) - 1; i >= 0; i-- {
+					signatory, distance := nh.Pop()
+					Expect(signatory).To(Equal(expected[i]))
+					Expect(distance).To(Equal(signatory.Distance((*id.Signatory)(&target))))
+				}
+				Expect(nh.Len()).To(Equal(0))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+})
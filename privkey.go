@@ -100,7 +100,10 @@ func NewPrivKey() *PrivKey {
 	return (*PrivKey)(privKey)
 }
 
-// Sign a Hash and return the resulting Signature, or error.
+// Sign a Hash and return the resulting Signature, or error. Before returning,
+// the Signatory is recovered from the Signature and checked against the
+// Signatory of this PrivKey, so that a change in the conventions of the
+// underlying signing library is caught immediately.
 func (privKey PrivKey) Sign(hash *Hash) (Signature, error) {
 	rsv, err := crypto.Sign(hash[:], (*ecdsa.PrivateKey)(&privKey))
 	if err != nil {
@@ -111,6 +114,14 @@ func (privKey PrivKey) Sign(hash *Hash) (Signature, error) {
 	}
 	signature := Signature{}
 	copy(signature[:], rsv)
+
+	signatory, err := signature.Signatory(hash)
+	if err != nil {
+		return Signature{}, fmt.Errorf("verifying signature=%v: %v", signature, err)
+	}
+	if expected := privKey.Signatory(); !signatory.Equal(&expected) {
+		return Signature{}, fmt.Errorf("verifying signature=%v: expected signatory=%v, got signatory=%v", signature, expected, signatory)
+	}
 	return signature, nil
 }
 
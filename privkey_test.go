@@ -27,6 +27,21 @@ var _ = Describe("Private keys", func() {
 				}
 				Expect(quick.Check(f, nil)).To(Succeed())
 			})
+
+			It("should always agree with the signatory of the private key", func() {
+				privKey := id.NewPrivKey()
+				f := func(data []byte) bool {
+					hash := id.NewHash(data)
+					sig, err := privKey.Sign(&hash)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(sig.IsValidFormat()).To(BeTrue())
+					got, err := sig.Signatory(&hash)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(got).To(Equal(privKey.Signatory()))
+					return true
+				}
+				Expect(quick.Check(f, nil)).To(Succeed())
+			})
 		})
 	})
 
@@ -0,0 +1,58 @@
+package id
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Rand is a deterministic PRNG seeded by a Hash. Each block of output is the
+// SHA2 256-bit hash of the seed concatenated with a big-endian counter, so two
+// Rands with the same seed always produce the same output. All randomized
+// helpers in this package draw from a Rand. Randomness that affects consensus
+// must be deterministic across nodes, so the seed must be agreed upon (for
+// example, the hash of a committed block) and never taken from a local source
+// of entropy. Rand is not suitable for generating secrets, and it is not safe
+// for concurrent use.
+type Rand struct {
+	buf     [SizeHintHash + 8]byte
+	counter uint64
+	block   Hash
+	offset  int
+}
+
+// NewRand returns a Rand seeded by the given Hash.
+func NewRand(seed Hash) *Rand {
+	r := &Rand{offset: SizeHintHash}
+	copy(r.buf[:SizeHintHash], seed[:])
+	return r
+}
+
+// Uint64 returns the next pseudo-random uint64.
+func (r *Rand) Uint64() uint64 {
+	if r.offset+8 > SizeHintHash {
+		binary.BigEndian.PutUint64(r.buf[SizeHintHash:], r.counter)
+		r.block = sha256.Sum256(r.buf[:])
+		r.counter++
+		r.offset = 0
+	}
+	v := binary.BigEndian.Uint64(r.block[r.offset:])
+	r.offset += 8
+	return v
+}
+
+// Intn returns a uniformly distributed pseudo-random integer in [0, n). This
+// function will panic if n is not positive.
+func (r *Rand) Intn(n int) int {
+	if n <= 0 {
+		panic(fmt.Errorf("expected n>0, got n=%v", n))
+	}
+	// Reject values from the incomplete final interval to avoid modulo bias.
+	limit := math.MaxUint64 - math.MaxUint64%uint64(n)
+	for {
+		if v := r.Uint64(); v < limit {
+			return int(v % uint64(n))
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package id_test
+
+import (
+	"testing/quick"
+
+	"github.com/muirglacier/id"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rands", func() {
+	Context("when using the same seed", func() {
+		It("should return the same output", func() {
+			f := func(seed [32]byte) bool {
+				r1 := id.NewRand(id.Hash(seed))
+				r2 := id.NewRand(id.Hash(seed))
+				for i := 0; i < 100; i++ {
+					Expect(r1.Uint64()).To(Equal(r2.Uint64()))
+					Expect(r1.Intn(i + 1)).To(Equal(r2.Intn(i + 1)))
+				}
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return the same shuffle", func() {
+			f := func(seed [32]byte, data [10][32]byte) bool {
+				signatories := make(id.Signatories, len(data))
+				for i := range signatories {
+					signatories[i] = id.Signatory(data[i])
+				}
+				shuffled := signatories.ShuffleRand(id.NewRand(id.Hash(seed)))
+				Expect(shuffled).To(Equal(signatories.ShuffleRand(id.NewRand(id.Hash(seed)))))
+				Expect(shuffled).To(Equal(signatories.Shuffle(id.Hash(seed))))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when using a fixed seed", func() {
+		It("should return a reproducible shuffle", func() {
+			signatories := make(id.Signatories, 5)
+			for i := range signatories {
+				signatories[i] = id.Signatory{byte(i)}
+			}
+			shuffled := signatories.ShuffleRand(id.NewRand(id.Hash{}))
+			order := make([]byte, len(shuffled))
+			for i := range shuffled {
+				order[i] = shuffled[i][0]
+			}
+			Expect(order).To(Equal([]byte{2, 1, 0, 4, 3}))
+		})
+	})
+
+	Context("when generating integers in a range", func() {
+		It("should stay within the range", func() {
+			f := func(seed [32]byte, n uint16) bool {
+				r := id.NewRand(id.Hash(seed))
+				for i := 0; i < 100; i++ {
+					Expect(r.Intn(int(n) + 1)).To(BeNumerically("<=", n))
+				}
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should panic when the range is empty", func() {
+			Expect(func() { id.NewRand(id.Hash{}).Intn(0) }).To(Panic())
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package id
+
+import (
+	"fmt"
+
+	"github.com/muirglacier/surge"
+)
+
+// SizeHintSchemedSignature is the number of bytes required to represent a
+// SchemedSignature in binary.
+const SizeHintSchemedSignature = 1 + SizeHintSignature
+
+// SignatureScheme identifies the scheme that was used to produce a Signature.
+type SignatureScheme uint8
+
+// Enumerate all supported SignatureSchemes.
+const (
+	// SignatureSchemeECDSA identifies secp256k1 ECDSA Signatures, as produced by
+	// PrivKey.Sign.
+	SignatureSchemeECDSA = SignatureScheme(0)
+)
+
+// SchemedSignature is a Signature that is tagged with the SignatureScheme that
+// was used to produce it, so that Signatures from different schemes can be
+// verified side-by-side.
+type SchemedSignature struct {
+	Scheme    SignatureScheme `json:"scheme"`
+	Signature Signature       `json:"signature"`
+}
+
+// NewSchemedSignature returns a SchemedSignature that tags the Signature with
+// the SignatureScheme.
+func NewSchemedSignature(scheme SignatureScheme, signature Signature) SchemedSignature {
+	return SchemedSignature{Scheme: scheme, Signature: signature}
+}
+
+// Signatory returns the Signatory that signed the Hash to produce this
+// SchemedSignature. It returns an error if the SignatureScheme is unknown.
+func (signature SchemedSignature) Signatory(hash *Hash) (Signatory, error) {
+	switch signature.Scheme {
+	case SignatureSchemeECDSA:
+		return signature.Signature.Signatory(hash)
+	default:
+		return Signatory{}, fmt.Errorf("identifying signature=%v: unknown scheme=%v", signature.Signature, signature.Scheme)
+	}
+}
+
+// Equal compares one SchemedSignature with another. If they are equal, then it
+// returns true, otherwise it returns false.
+func (signature SchemedSignature) Equal(other *SchemedSignature) bool {
+	return signature.Scheme == other.Scheme && signature.Signature.Equal(&other.Signature)
+}
+
+// SizeHint returns the number of bytes required to represent a
+// SchemedSignature in binary.
+func (SchemedSignature) SizeHint() int {
+	return SizeHintSchemedSignature
+}
+
+// Marshal into binary.
+func (signature SchemedSignature) Marshal(buf []byte, rem int) ([]byte, int, error) {
+	if len(buf) < SizeHintSchemedSignature || rem < SizeHintSchemedSignature {
+		return buf, rem, surge.ErrUnexpectedEndOfBuffer
+	}
+	buf[0] = byte(signature.Scheme)
+	return signature.Signature.Marshal(buf[1:], rem-1)
+}
+
+// Unmarshal from binary.
+func (signature *SchemedSignature) Unmarshal(buf []byte, rem int) ([]byte, int, error) {
+	if len(buf) < SizeHintSchemedSignature || rem < SizeHintSchemedSignature {
+		return buf, rem, surge.ErrUnexpectedEndOfBuffer
+	}
+	signature.Scheme = SignatureScheme(buf[0])
+	return signature.Signature.Unmarshal(buf[1:], rem-1)
+}
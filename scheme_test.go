@@ -0,0 +1,93 @@
+package id_test
+
+import (
+	"encoding/json"
+	"testing/quick"
+
+	"github.com/muirglacier/id"
+	"github.com/muirglacier/surge"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schemed signatures", func() {
+	Context("when using the ECDSA scheme", func() {
+		It("should return the same signatory as the signature", func() {
+			f := func(data []byte) bool {
+				hash := id.NewHash(data)
+				privKey := id.NewPrivKey()
+				sig, err := privKey.Sign(&hash)
+				Expect(err).ToNot(HaveOccurred())
+				got, err := id.NewSchemedSignature(id.SignatureSchemeECDSA, sig).Signatory(&hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(got).To(Equal(privKey.Signatory()))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when using an unknown scheme", func() {
+		It("should return an error", func() {
+			f := func(scheme uint8, data [65]byte, hash [32]byte) bool {
+				if id.SignatureScheme(scheme) == id.SignatureSchemeECDSA {
+					return true
+				}
+				_, err := id.NewSchemedSignature(id.SignatureScheme(scheme), id.Signature(data)).Signatory((*id.Hash)(&hash))
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("unknown scheme"))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when marshaling and then unmarshaling using binary", func() {
+		It("should equal itself", func() {
+			f := func(scheme uint8, data [65]byte) bool {
+				sig := id.NewSchemedSignature(id.SignatureScheme(scheme), id.Signature(data))
+				marshaled, err := surge.ToBinary(sig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(marshaled).To(HaveLen(id.SizeHintSchemedSignature))
+				unmarshaled := id.SchemedSignature{}
+				err = surge.FromBinary(&unmarshaled, marshaled)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sig.Equal(&unmarshaled)).To(BeTrue())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when unmarshaling random bytes using binary", func() {
+		It("should equal return an error", func() {
+			f := func(data []byte) bool {
+				if len(data) >= id.SizeHintSchemedSignature {
+					return true
+				}
+				unmarshaled := id.SchemedSignature{}
+				err := surge.FromBinary(&unmarshaled, data)
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when marshaling and then unmarshaling using JSON", func() {
+		It("should equal itself", func() {
+			f := func(scheme uint8, data [65]byte) bool {
+				sig := id.NewSchemedSignature(id.SignatureScheme(scheme), id.Signature(data))
+				marshaled, err := json.Marshal(sig)
+				Expect(err).ToNot(HaveOccurred())
+				unmarshaled := id.SchemedSignature{}
+				err = json.Unmarshal(marshaled, &unmarshaled)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sig.Equal(&unmarshaled)).To(BeTrue())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+})
@@ -2,10 +2,13 @@ package id
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/muirglacier/surge"
@@ -21,8 +24,56 @@ const (
 // where V is either 0 or 1.
 type Signature [SizeHintSignature]byte
 
+// SignatureFromBytes returns a Signature with the same content as the slice of
+// bytes. It returns an error if the slice is not exactly SizeHintSignature
+// bytes long.
+func SignatureFromBytes(data []byte) (Signature, error) {
+	if len(data) != SizeHintSignature {
+		return Signature{}, fmt.Errorf("expected len=%v, got len=%v", SizeHintSignature, len(data))
+	}
+	signature := Signature{}
+	copy(signature[:], data)
+	return signature, nil
+}
+
+// IsValidFormat returns true if the Signature is structurally well-formed,
+// otherwise it returns false. Currently, this means that V is either 0 or 1. It
+// is much cheaper than recovering the Signatory, so it can be used to reject
+// malformed Signatures early.
+func (signature Signature) IsValidFormat() bool {
+	v := signature[SizeHintSignature-1]
+	return v == 0 || v == 1
+}
+
+// ConcatSignatures returns the concatenation of the Signatures in binary. The
+// result can be split back into Signatures using SplitSignatures.
+func ConcatSignatures(signatures []Signature) []byte {
+	data := make([]byte, 0, len(signatures)*SizeHintSignature)
+	for _, signature := range signatures {
+		data = append(data, signature[:]...)
+	}
+	return data
+}
+
+// SplitSignatures splits a concatenation of Signatures in binary back into the
+// individual Signatures. It returns an error if the length of the data is not a
+// multiple of SizeHintSignature.
+func SplitSignatures(data []byte) ([]Signature, error) {
+	if len(data)%SizeHintSignature != 0 {
+		return nil, fmt.Errorf("expected len to be a multiple of %v, got len=%v", SizeHintSignature, len(data))
+	}
+	signatures := make([]Signature, len(data)/SizeHintSignature)
+	for i := range signatures {
+		copy(signatures[i][:], data[i*SizeHintSignature:])
+	}
+	return signatures, nil
+}
+
 // Signatory returns the that signed the Hash to produce this Signature.
 func (signature Signature) Signatory(hash *Hash) (Signatory, error) {
+	if !signature.IsValidFormat() {
+		return Signatory{}, fmt.Errorf("identifying signature=%v: invalid format", signature)
+	}
 	pubKey, err := crypto.SigToPub(hash[:], signature[:])
 	if err != nil {
 		return Signatory{}, fmt.Errorf("identifying signature=%v: %v", signature, err)
@@ -30,6 +81,13 @@ func (signature Signature) Signatory(hash *Hash) (Signatory, error) {
 	return NewSignatory((*PubKey)(pubKey)), nil
 }
 
+// Fingerprint returns the SHA2 256-bit hash of the Signature. It can be used to
+// key, index, and de-duplicate Signatures using a Hash instead of the full
+// Signature.
+func (signature Signature) Fingerprint() Hash {
+	return NewHash(signature[:])
+}
+
 // Equal compares one Signature with another. If they are equal, then it returns
 // true, otherwise it returns false.
 func (signature Signature) Equal(other *Signature) bool {
@@ -107,6 +165,18 @@ func NewSignatory(pubKey *PubKey) Signatory {
 	return Signatory(sha256.Sum256(pubKeyData))
 }
 
+// SignatoryFromBytes returns a Signatory with the same content as the slice of
+// bytes. It returns an error if the slice is not exactly SizeHintSignatory
+// bytes long.
+func SignatoryFromBytes(data []byte) (Signatory, error) {
+	if len(data) != SizeHintSignatory {
+		return Signatory{}, fmt.Errorf("expected len=%v, got len=%v", SizeHintSignatory, len(data))
+	}
+	signatory := Signatory{}
+	copy(signatory[:], data)
+	return signatory, nil
+}
+
 // Equal compares one Signatory with another. If they are equal, then it returns
 // true, otherwise it returns false.
 func (signatory Signatory) Equal(other *Signatory) bool {
@@ -165,3 +235,67 @@ func (signatory *Signatory) UnmarshalJSON(data []byte) error {
 func (signatory Signatory) String() string {
 	return base64.RawURLEncoding.EncodeToString(signatory[:])
 }
+
+// MatchesPublicKey returns true if the Signatory is derived from the ECDSA
+// public key, otherwise it returns false. The comparison is constant-time.
+func (signatory Signatory) MatchesPublicKey(pubKey ecdsa.PublicKey) bool {
+	expected := NewSignatory((*PubKey)(&pubKey))
+	return subtle.ConstantTimeCompare(signatory[:], expected[:]) == 1
+}
+
+// Hash64 returns the 64-bit FNV-1a hash of the Signatory. Unlike taking the
+// leading bytes of the Signatory, every byte contributes to the result, so it is
+// suitable for selecting shards in sharded maps. It is not a cryptographic hash.
+func (signatory Signatory) Hash64() uint64 {
+	h := fnv.New64a()
+	h.Write(signatory[:])
+	return h.Sum64()
+}
+
+// Label returns a short, human-readable label for the Signatory, such as
+// "brave-otter-3fa2". It is deterministic, and distinct Signatories usually
+// have distinct labels, but collisions are possible. Labels are only intended
+// to make logs easier to read, and must never be used to identify a
+// Signatory.
+func (signatory Signatory) Label() string {
+	adjective := labelAdjectives[int(signatory[0])%len(labelAdjectives)]
+	noun := labelNouns[int(signatory[1])%len(labelNouns)]
+	return fmt.Sprintf("%v-%v-%x", adjective, noun, signatory[2:4])
+}
+
+var labelAdjectives = [32]string{
+	"amber", "bold", "brave", "calm", "clever", "cosmic", "crisp", "dusty",
+	"eager", "fancy", "gentle", "glossy", "happy", "icy", "jolly", "keen",
+	"lively", "lucky", "mellow", "misty", "noble", "proud", "quiet", "rapid",
+	"rusty", "shiny", "silent", "sunny", "swift", "tidy", "vivid", "witty",
+}
+
+var labelNouns = [32]string{
+	"badger", "beaver", "bison", "crane", "falcon", "ferret", "gecko", "heron",
+	"ibis", "jackal", "koala", "lemur", "lynx", "marmot", "moose", "newt",
+	"ocelot", "orca", "otter", "panda", "puffin", "quail", "raven", "seal",
+	"shrew", "stoat", "tapir", "toucan", "vole", "walrus", "wombat", "yak",
+}
+
+// Signatories is a slice of Signatory.
+type Signatories []Signatory
+
+// Shuffle returns a copy of the Signatories permuted by a Fisher-Yates shuffle.
+// The shuffle is driven by a Rand seeded with the given Hash, so every caller
+// that uses the same seed and the same Signatories will get the same
+// permutation. The receiver is unmodified.
+func (signatories Signatories) Shuffle(seed Hash) Signatories {
+	return signatories.ShuffleRand(NewRand(seed))
+}
+
+// ShuffleRand is the same as Shuffle but it draws from the given Rand instead
+// of seeding a new one.
+func (signatories Signatories) ShuffleRand(r *Rand) Signatories {
+	shuffled := make(Signatories, len(signatories))
+	copy(shuffled, signatories)
+	for i := len(shuffled) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	}
+	return shuffled
+}
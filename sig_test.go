@@ -2,6 +2,7 @@ package id_test
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/json"
 	"testing/quick"
 
@@ -12,6 +13,126 @@ import (
 )
 
 var _ = Describe("Signatures", func() {
+	Context("when converting from bytes", func() {
+		It("should equal the bytes when the length is correct", func() {
+			f := func(data [65]byte) bool {
+				got, err := id.SignatureFromBytes(data[:])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(got).To(Equal(id.Signature(data)))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when the bytes are too short", func() {
+			f := func(data [65]byte, n uint8) bool {
+				_, err := id.SignatureFromBytes(data[:int(n)%65])
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when the bytes are too long", func() {
+			f := func(data [65]byte, extra []byte) bool {
+				_, err := id.SignatureFromBytes(append(data[:], append(extra, 0)...))
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when checking the format", func() {
+		It("should return true when the recovery byte is 0 or 1", func() {
+			f := func(data [65]byte, v bool) bool {
+				data[64] = 0
+				if v {
+					data[64] = 1
+				}
+				Expect(id.Signature(data).IsValidFormat()).To(BeTrue())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return false when the recovery byte is not 0 or 1", func() {
+			f := func(data [65]byte) bool {
+				for _, v := range []byte{2, 27, 28, data[64] | 2} {
+					data[64] = v
+					Expect(id.Signature(data).IsValidFormat()).To(BeFalse())
+				}
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when identifying a malformed signature", func() {
+			f := func(data [65]byte, hash [32]byte) bool {
+				data[64] |= 2
+				_, err := id.Signature(data).Signatory((*id.Hash)(&hash))
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when fingerprinting", func() {
+		It("should return the hash of the signature", func() {
+			f := func(data [65]byte) bool {
+				sig := id.Signature(data)
+				Expect(sig.Fingerprint()).To(Equal(id.NewHash(data[:])))
+				Expect(sig.Fingerprint()).To(Equal(sig.Fingerprint()))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return different fingerprints for different signatures", func() {
+			f := func(data, other [65]byte) bool {
+				if data == other {
+					return true
+				}
+				Expect(id.Signature(data).Fingerprint()).ToNot(Equal(id.Signature(other).Fingerprint()))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when concatenating and then splitting", func() {
+		It("should equal itself", func() {
+			f := func(data [][65]byte) bool {
+				sigs := make([]id.Signature, len(data))
+				for i := range sigs {
+					sigs[i] = id.Signature(data[i])
+				}
+				concatenated := id.ConcatSignatures(sigs)
+				Expect(concatenated).To(HaveLen(65 * len(sigs)))
+				split, err := id.SplitSignatures(concatenated)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(split).To(Equal(sigs))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when splitting bytes with a malformed length", func() {
+		It("should return an error", func() {
+			f := func(data []byte) bool {
+				if len(data)%65 == 0 {
+					return true
+				}
+				_, err := id.SplitSignatures(data)
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
 	Context("when marshaling and then unmarshaling using binary", func() {
 		It("should equal itself", func() {
 			f := func(data [65]byte) bool {
@@ -86,6 +207,36 @@ var _ = Describe("Signatures", func() {
 })
 
 var _ = Describe("Signatories", func() {
+	Context("when converting from bytes", func() {
+		It("should equal the bytes when the length is correct", func() {
+			f := func(data [32]byte) bool {
+				got, err := id.SignatoryFromBytes(data[:])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(got).To(Equal(id.Signatory(data)))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when the bytes are too short", func() {
+			f := func(data [32]byte, n uint8) bool {
+				_, err := id.SignatoryFromBytes(data[:int(n)%32])
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return an error when the bytes are too long", func() {
+			f := func(data [32]byte, extra []byte) bool {
+				_, err := id.SignatoryFromBytes(append(data[:], append(extra, 0)...))
+				Expect(err).To(HaveOccurred())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
 	Context("when marshaling and then unmarshaling using binary", func() {
 		It("should equal itself", func() {
 			f := func(data [32]byte) bool {
@@ -157,4 +308,125 @@ var _ = Describe("Signatories", func() {
 			Expect(quick.Check(f, nil)).To(Succeed())
 		})
 	})
+
+	Context("when matching public keys", func() {
+		It("should return true for the public key of the signatory", func() {
+			f := func() bool {
+				privKey := id.NewPrivKey()
+				Expect(privKey.Signatory().MatchesPublicKey(privKey.PublicKey)).To(BeTrue())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return false for a different public key", func() {
+			f := func() bool {
+				privKey := id.NewPrivKey()
+				otherPrivKey := id.NewPrivKey()
+				Expect(privKey.Signatory().MatchesPublicKey(otherPrivKey.PublicKey)).To(BeFalse())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when hashing to 64 bits", func() {
+		It("should always return the same hash", func() {
+			f := func(data [32]byte) bool {
+				Expect(id.Signatory(data).Hash64()).To(Equal(id.Signatory(data).Hash64()))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should depend on every byte", func() {
+			f := func(data [32]byte, i uint8) bool {
+				signatory := id.Signatory(data)
+				other := signatory
+				other[int(i)%32]++
+				Expect(signatory.Hash64()).ToNot(Equal(other.Hash64()))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should distribute random signatories uniformly", func() {
+			n := uint64(16)
+			samples := 160000
+			counts := make([]int, n)
+			for i := 0; i < samples; i++ {
+				signatory := id.Signatory{}
+				rand.Read(signatory[:])
+				counts[signatory.Hash64()%n]++
+			}
+			expected := samples / int(n)
+			for _, count := range counts {
+				Expect(count).To(BeNumerically("~", expected, expected/10))
+			}
+		})
+	})
+
+	Context("when labelling", func() {
+		It("should always return the same label", func() {
+			f := func(data [32]byte) bool {
+				Expect(id.Signatory(data).Label()).To(Equal(id.Signatory(data).Label()))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should usually return different labels for different signatories", func() {
+			labels := map[string]struct{}{}
+			for i := 0; i < 1000; i++ {
+				signatory := id.Signatory{}
+				rand.Read(signatory[:])
+				labels[signatory.Label()] = struct{}{}
+			}
+			Expect(len(labels)).To(BeNumerically(">=", 990))
+		})
+	})
+
+	Context("when shuffling", func() {
+		It("should return a permutation without modifying the input", func() {
+			f := func(seed [32]byte, n uint8) bool {
+				signatories := randomSignatories(int(n))
+				original := make(id.Signatories, len(signatories))
+				copy(original, signatories)
+				shuffled := signatories.Shuffle(id.Hash(seed))
+				Expect(signatories).To(Equal(original))
+				Expect(shuffled).To(ConsistOf(original))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return the same permutation for the same seed", func() {
+			f := func(seed [32]byte) bool {
+				signatories := randomSignatories(100)
+				Expect(signatories.Shuffle(id.Hash(seed))).To(Equal(signatories.Shuffle(id.Hash(seed))))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should return different permutations for different seeds", func() {
+			f := func(seed, otherSeed [32]byte) bool {
+				if seed == otherSeed {
+					return true
+				}
+				signatories := randomSignatories(100)
+				Expect(signatories.Shuffle(id.Hash(seed))).ToNot(Equal(signatories.Shuffle(id.Hash(otherSeed))))
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
 })
+
+func randomSignatories(n int) id.Signatories {
+	signatories := make(id.Signatories, n)
+	for i := range signatories {
+		rand.Read(signatories[i][:])
+	}
+	return signatories
+}
@@ -0,0 +1,43 @@
+package id
+
+// A Store is a content-addressed store for binary data. Data is addressed by
+// its Hash, as returned by NewHash.
+type Store interface {
+	// Put data into the Store and return its Hash.
+	Put(data []byte) Hash
+
+	// Get the data with the given Hash. It returns false if the Store does not
+	// have the data.
+	Get(hash Hash) ([]byte, bool)
+}
+
+// MemStore is an in-memory Store. It is not safe for concurrent use.
+type MemStore struct {
+	data map[Hash][]byte
+}
+
+// NewMemStore returns an empty MemStore.
+func NewMemStore() *MemStore {
+	return &MemStore{data: map[Hash][]byte{}}
+}
+
+// Put a copy of the data into the MemStore and return its Hash.
+func (store *MemStore) Put(data []byte) Hash {
+	hash := NewHash(data)
+	store.data[hash] = append([]byte{}, data...)
+	return hash
+}
+
+// Get a copy of the data with the given Hash. It returns false if the MemStore
+// does not have the data, or if the stored data no longer hashes to the given
+// Hash.
+func (store *MemStore) Get(hash Hash) ([]byte, bool) {
+	data, ok := store.data[hash]
+	if !ok {
+		return nil, false
+	}
+	if got := NewHash(data); !got.Equal(&hash) {
+		return nil, false
+	}
+	return append([]byte{}, data...), true
+}
@@ -0,0 +1,62 @@
+package id_test
+
+import (
+	"bytes"
+	"testing/quick"
+
+	"github.com/muirglacier/id"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stores", func() {
+	Context("when putting and then getting data", func() {
+		It("should return the data by its hash", func() {
+			f := func(data []byte) bool {
+				store := id.NewMemStore()
+				hash := store.Put(data)
+				expected := id.NewHash(data)
+				Expect(hash.Equal(&expected)).To(BeTrue())
+				got, ok := store.Get(hash)
+				Expect(ok).To(BeTrue())
+				Expect(bytes.Equal(got, data)).To(BeTrue())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+
+		It("should not be affected by modifying the data", func() {
+			f := func(data []byte) bool {
+				if len(data) == 0 {
+					return true
+				}
+				store := id.NewMemStore()
+				original := append([]byte{}, data...)
+				hash := store.Put(data)
+				data[0]++
+				got, ok := store.Get(hash)
+				Expect(ok).To(BeTrue())
+				Expect(bytes.Equal(got, original)).To(BeTrue())
+				got[0]++
+				got, ok = store.Get(hash)
+				Expect(ok).To(BeTrue())
+				Expect(bytes.Equal(got, original)).To(BeTrue())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+
+	Context("when getting absent data", func() {
+		It("should return false", func() {
+			f := func(data []byte) bool {
+				store := id.NewMemStore()
+				_, ok := store.Get(id.NewHash(data))
+				Expect(ok).To(BeFalse())
+				return true
+			}
+			Expect(quick.Check(f, nil)).To(Succeed())
+		})
+	})
+})